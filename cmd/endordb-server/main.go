@@ -0,0 +1,37 @@
+// Command endordb-server exposes an ObjectDB driver over gRPC so GRPCDB
+// clients can talk to it from a separate process.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"github.com/niks3089/endor-coding-test/endordb"
+)
+
+func main() {
+	driver := flag.String("driver", "memory", "registered endordb driver to serve (e.g. redis, memory)")
+	addr := flag.String("listen", ":7890", "address to listen on")
+	redisAddr := flag.String("redis-addr", "127.0.0.1:6379", "redis address, used when -driver=redis")
+	redisPassword := flag.String("redis-password", "", "redis password, used when -driver=redis")
+	flag.Parse()
+
+	db, err := endordb.New(*driver, map[string]string{
+		"addr":     *redisAddr,
+		"password": *redisPassword,
+	})
+	if err != nil {
+		log.Fatalf("endordb-server: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("endordb-server: %v", err)
+	}
+
+	log.Printf("endordb-server: serving %q driver on %s", *driver, *addr)
+	if err := endordb.NewServer(db).Serve(lis); err != nil {
+		log.Fatalf("endordb-server: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package endordb
+
+import (
+	"context"
+	"fmt"
+)
+
+// ObjectDB is the storage interface implemented by every driver (Redis, the
+// in-memory store, the gRPC client, ...). Callers should depend on this
+// interface rather than any concrete driver so the backend can be swapped at
+// runtime.
+type ObjectDB interface {
+	// Store will store the object in the data store. The object will have a
+	// name and kind, and the Store method should create a unique ID.
+	Store(ctx context.Context, object Object) error
+	// GetObjectByID will retrieve the object with the provided ID.
+	GetObjectByID(ctx context.Context, id string) (Object, error)
+	// GetObjectsByName will retrieve the objects with the given exact name.
+	GetObjectsByName(ctx context.Context, name string) ([]Object, error)
+	// GetObjectsByNameSlug will retrieve every object whose name slugifies to
+	// the given slug, resolving any collisions between distinct names that
+	// share a slug (e.g. "Al Pacino" and "al-pacino") by returning them all.
+	GetObjectsByNameSlug(ctx context.Context, slug string) ([]Object, error)
+	// ListObjects will return a list of all objects of the given kind.
+	ListObjects(ctx context.Context, kind string) ([]Object, error)
+	// DeleteObject will delete the object.
+	DeleteObject(ctx context.Context, id string) error
+}
+
+// Constructor builds a driver instance from a set of string options (e.g.
+// "addr"/"password" for Redis, "target" for the gRPC client).
+type Constructor func(opts map[string]string) (ObjectDB, error)
+
+var drivers = map[string]Constructor{}
+
+// Register makes a driver available under name for New to construct. It is
+// meant to be called from a driver's init() function, following the same
+// pattern as database/sql.Register.
+func Register(name string, constructor Constructor) {
+	if constructor == nil {
+		panic("endordb: Register constructor is nil")
+	}
+	if _, dup := drivers[name]; dup {
+		panic("endordb: Register called twice for driver " + name)
+	}
+	drivers[name] = constructor
+}
+
+// New constructs the registered driver identified by name with the given
+// options.
+func New(name string, opts map[string]string) (ObjectDB, error) {
+	constructor, ok := drivers[name]
+	if !ok {
+		return nil, fmt.Errorf("endordb: unknown driver %q (forgotten import?)", name)
+	}
+	return constructor(opts)
+}
+
+// Drivers returns the names of the currently registered drivers.
+func Drivers() []string {
+	names := make([]string, 0, len(drivers))
+	for name := range drivers {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,61 @@
+package endordb
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingDB(t *testing.T) {
+	var buf bytes.Buffer
+	db := NewLoggingDB(NewMemoryDB(), LogOptions{Logger: log.New(&buf, "", 0)})
+
+	person := getPerson("alice")
+	err := db.Store(context.Background(), person)
+	assert.NoError(t, err)
+
+	_, err = db.GetObjectByID(context.Background(), "unknown")
+	assert.Error(t, err)
+
+	lines := buf.String()
+	assert.Contains(t, lines, `"method":"Store"`)
+	assert.Contains(t, lines, `"id":"`+person.GetID()+`"`)
+	assert.Contains(t, lines, `"method":"GetObjectByID"`)
+	assert.Contains(t, lines, `"error":"object not found"`)
+}
+
+func TestLoggingDBApacheFormat(t *testing.T) {
+	var buf bytes.Buffer
+	db := NewLoggingDB(NewMemoryDB(), LogOptions{
+		Format: "%m %k %n %s",
+		Logger: log.New(&buf, "", 0),
+	})
+
+	person := getPerson("alice")
+	err := db.Store(context.Background(), person)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Store person alice ok\n", buf.String())
+}
+
+func TestMetricsDB(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	db := NewMetricsDB(NewMemoryDB(), reg)
+
+	person := getPerson("alice")
+	err := db.Store(context.Background(), person)
+	assert.NoError(t, err)
+
+	_, err = db.GetObjectByID(context.Background(), "unknown")
+	assert.Error(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(
+		db.(*metricsDB).opsTotal.WithLabelValues("Store", "person", "ok")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(
+		db.(*metricsDB).opsTotal.WithLabelValues("GetObjectByID", "", "error")))
+}
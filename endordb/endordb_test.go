@@ -0,0 +1,345 @@
+package endordb
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getEnv(key, fallback string) string {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		value = fallback
+	}
+	return value
+}
+
+func randomString(length int) string {
+	letterBytes := "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	rand.Seed(time.Now().UnixNano())
+	b := make([]byte, length)
+	for i := range b {
+		b[i] = letterBytes[rand.Intn(len(letterBytes))]
+	}
+	return string(b)
+}
+
+func getPerson(name string) *Person {
+	return &Person{
+		Name:      name,
+		LastName:  "Johnson",
+		Birthday:  "01-02-1990",
+		BirthDate: time.Date(1989, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func getAnimal(name string) *Animal {
+	return &Animal{
+		Name:    name,
+		Type:    "Cat",
+		OwnerID: "Johnson",
+	}
+}
+
+// backends lists the driver/opts pairs the table-driven suite below is run
+// against. A backend whose constructor fails (e.g. no Redis reachable) is
+// skipped rather than failing the run.
+func backends() map[string]map[string]string {
+	return map[string]map[string]string{
+		"memory": {},
+		"redis": {
+			"addr":     getEnv("REDIS_HOST", "127.0.0.1:6379"),
+			"password": getEnv("REDIS_PASSWORD", ""),
+		},
+		"grpc": {},
+	}
+}
+
+// newTestDB constructs the named backend, skipping the calling test if the
+// backend isn't reachable in this environment.
+func newTestDB(t *testing.T, name string, opts map[string]string) ObjectDB {
+	t.Helper()
+
+	if name == "grpc" {
+		return newTestGRPCDB(t)
+	}
+
+	db, err := New(name, opts)
+	if err != nil {
+		t.Skipf("backend %q unavailable: %v", name, err)
+	}
+
+	if r, ok := db.(*RedisDB); ok {
+		if _, err := r.FlushAll(); err != nil {
+			t.Skipf("backend %q unavailable: %v", name, err)
+		}
+	}
+
+	return db
+}
+
+// newTestGRPCDB starts an in-process endordb-server backed by a fresh
+// MemoryDB and returns a GRPCDB client dialed to it, so the suite actually
+// exercises the gRPC wire path instead of only the drivers it wraps.
+func newTestGRPCDB(t *testing.T) ObjectDB {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("grpc backend unavailable: %v", err)
+	}
+
+	srv := NewServer(NewMemoryDB())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	db, err := NewGRPCDB(lis.Addr().String())
+	if err != nil {
+		t.Skipf("grpc backend unavailable: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func forEachBackend(t *testing.T, run func(t *testing.T, db ObjectDB)) {
+	for name, opts := range backends() {
+		name, opts := name, opts
+		t.Run(name, func(t *testing.T) {
+			db := newTestDB(t, name, opts)
+			run(t, db)
+		})
+	}
+}
+
+func TestNameSet(t *testing.T) {
+	person := getPerson("alice")
+	err := person.SetName("")
+	assert.NoError(t, err)
+	err = person.SetName("ab:c:")
+	assert.NoError(t, err)
+	// Names are slugified only inside secondary index keys, so the raw name
+	// is no longer restricted in any way, including the historical "::"
+	// delimiter.
+	err = person.SetName("ab::c")
+	assert.NoError(t, err)
+}
+
+func TestStore(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db ObjectDB) {
+		person := getPerson("alice")
+		animal := getAnimal("fluffy")
+
+		err := db.Store(context.Background(), person)
+		assert.NoError(t, err)
+
+		err = db.Store(context.Background(), animal)
+		assert.NoError(t, err)
+
+		person.Name = ""
+		err = db.Store(context.Background(), person)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetObjectByID(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db ObjectDB) {
+		person := getPerson("alice")
+		animal := getAnimal("fluffy")
+
+		err := db.Store(context.Background(), person)
+		assert.NoError(t, err)
+
+		err = db.Store(context.Background(), animal)
+		assert.NoError(t, err)
+
+		// Happy case
+		obj, err := db.GetObjectByID(context.Background(), person.GetID())
+		assert.NoError(t, err)
+		per, ok := obj.(*Person)
+		assert.Equal(t, true, ok)
+		assert.Equal(t, person.Name, per.Name)
+		assert.Equal(t, person.Birthday, per.Birthday)
+
+		obj, err = db.GetObjectByID(context.Background(), animal.GetID())
+		assert.NoError(t, err)
+		res, ok := obj.(*Animal)
+		assert.Equal(t, true, ok)
+		assert.Equal(t, animal.Name, res.Name)
+		assert.Equal(t, animal.Type, res.Type)
+
+		// Test with empty id
+		_, err = db.GetObjectByID(context.Background(), "")
+		assert.Error(t, err)
+
+		// Test with unknown id
+		_, err = db.GetObjectByID(context.Background(), "unknown")
+		assert.Error(t, err)
+	})
+}
+
+func TestGetObjectsByName(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db ObjectDB) {
+		pName := randomString(10)
+		aName := randomString(10)
+
+		person := getPerson(pName)
+		animal := getAnimal(aName)
+
+		var ap = make(map[string]int)
+
+		for i := 0; i < 5; i++ {
+			ap[person.Name]++
+			ap[animal.Name]++
+
+			err := db.Store(context.Background(), person)
+			assert.NoError(t, err)
+
+			err = db.Store(context.Background(), animal)
+			assert.NoError(t, err)
+		}
+
+		// Happy path
+		objs, err := db.GetObjectsByName(context.Background(), pName)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, len(objs))
+
+		for _, obj := range objs {
+			ap[obj.GetName()]--
+		}
+		assert.Equal(t, 0, ap[pName])
+
+		objs, err = db.GetObjectsByName(context.Background(), aName)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, len(objs))
+
+		for _, obj := range objs {
+			ap[obj.GetName()]--
+		}
+		assert.Equal(t, 0, ap[aName])
+
+		// Empty name
+		_, err = db.GetObjectsByName(context.Background(), "")
+		assert.Error(t, err)
+
+		// Unknown name
+		objs, err = db.GetObjectsByName(context.Background(), "unknown")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(objs))
+	})
+}
+
+func TestGetObjectsByNameSlug(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db ObjectDB) {
+		base := randomString(10)
+
+		// "Foo Bar" and "foo-bar" collide on the same slug but are distinct
+		// exact names.
+		spaced := getPerson(base + " Bar")
+		dashed := getPerson(base + "-bar")
+
+		err := db.Store(context.Background(), spaced)
+		assert.NoError(t, err)
+		err = db.Store(context.Background(), dashed)
+		assert.NoError(t, err)
+
+		exact, err := db.GetObjectsByName(context.Background(), spaced.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(exact))
+		assert.Equal(t, spaced.Name, exact[0].GetName())
+
+		collisions, err := db.GetObjectsByNameSlug(context.Background(), slugify(spaced.Name))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(collisions))
+
+		// Empty slug
+		_, err = db.GetObjectsByNameSlug(context.Background(), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestListObjects(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db ObjectDB) {
+		pName := randomString(10)
+		aName := randomString(10)
+
+		person := getPerson(pName)
+		animal := getAnimal(aName)
+
+		var pMap = make(map[string]bool)
+		var aMap = make(map[string]bool)
+
+		for i := 0; i < 5; i++ {
+			person.Name = pName + fmt.Sprintf("%d", i)
+			pMap[person.Name] = true
+
+			animal.Name = pName + fmt.Sprintf("%d", i)
+			aMap[animal.Name] = true
+
+			err := db.Store(context.Background(), person)
+			assert.NoError(t, err)
+
+			err = db.Store(context.Background(), animal)
+			assert.NoError(t, err)
+		}
+
+		// Happy path
+		objs, err := db.ListObjects(context.Background(), person.GetKind())
+		assert.NoError(t, err)
+		assert.Equal(t, 5, len(objs))
+
+		for _, obj := range objs {
+			delete(pMap, obj.GetName())
+		}
+		assert.Equal(t, 0, len(pMap))
+
+		objs, err = db.ListObjects(context.Background(), animal.GetKind())
+		assert.NoError(t, err)
+		assert.Equal(t, 5, len(objs))
+
+		for _, obj := range objs {
+			delete(aMap, obj.GetName())
+		}
+		assert.Equal(t, 0, len(aMap))
+
+		// Unknown kind
+		objs, err = db.ListObjects(context.Background(), "unknown")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(objs))
+	})
+}
+
+func TestDeleteObject(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db ObjectDB) {
+		person := getPerson("alice")
+		animal := getAnimal("fluffy")
+
+		err := db.Store(context.Background(), person)
+		assert.NoError(t, err)
+
+		err = db.Store(context.Background(), animal)
+		assert.NoError(t, err)
+
+		// Happy case
+		err = db.DeleteObject(context.Background(), person.GetID())
+		assert.NoError(t, err)
+
+		// Delete again
+		err = db.DeleteObject(context.Background(), person.GetID())
+		assert.NoError(t, err)
+
+		// Try to get the object
+		_, err = db.GetObjectByID(context.Background(), person.GetID())
+		assert.Error(t, err)
+
+		// Delete unknown object
+		err = db.DeleteObject(context.Background(), "unknown")
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,133 @@
+package endordb
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/niks3089/endor-coding-test/endordb/proto"
+)
+
+func init() {
+	Register("grpc", func(opts map[string]string) (ObjectDB, error) {
+		return NewGRPCDB(opts["target"])
+	})
+}
+
+// GRPCDB is an ObjectDB client that talks to a remote endordb-server over
+// gRPC, so the actual store can live in a separate process.
+type GRPCDB struct {
+	conn     *grpc.ClientConn
+	client   pb.ObjectDBClient
+	registry *Registry
+}
+
+// NewGRPCDB dials the endordb-server listening at target. RPCs are encoded
+// with pb.CodecName (JSON) rather than grpc's default "proto" codec, since
+// the message types in endordb/proto are hand-maintained structs and don't
+// implement proto.Message.
+func NewGRPCDB(target string, opts ...Option) (*GRPCDB, error) {
+	conn, err := grpc.Dial(
+		target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(pb.CodecName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &GRPCDB{conn: conn, client: pb.NewObjectDBClient(conn), registry: newDriverOptions(opts).registry}, nil
+}
+
+// Close tears down the underlying gRPC connection.
+func (db *GRPCDB) Close() error {
+	return db.conn.Close()
+}
+
+func (db *GRPCDB) Store(ctx context.Context, object Object) error {
+	if object.GetName() == "" {
+		return errors.New("missing object name")
+	}
+	if object.GetKind() == "" {
+		return errors.New("missing object kind")
+	}
+
+	payload, err := marshalObject(object)
+	if err != nil {
+		return err
+	}
+
+	resp, err := db.client.Put(ctx, &pb.PutRequest{
+		Kind:    object.GetKind(),
+		Name:    object.GetName(),
+		Payload: payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	object.SetID(resp.Id)
+	return nil
+}
+
+func (db *GRPCDB) GetObjectByID(ctx context.Context, id string) (Object, error) {
+	resp, err := db.client.Get(ctx, &pb.GetRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return db.registry.unmarshalObject(resp.Kind, resp.Payload)
+}
+
+func (db *GRPCDB) GetObjectsByName(ctx context.Context, name string) ([]Object, error) {
+	if name == "" {
+		return nil, errors.New("invalid request. empty name")
+	}
+
+	resp, err := db.client.GetByName(ctx, &pb.GetByNameRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObjects(db.registry, resp.Objects)
+}
+
+func (db *GRPCDB) GetObjectsByNameSlug(ctx context.Context, slug string) ([]Object, error) {
+	if slug == "" {
+		return nil, errors.New("invalid request. empty slug")
+	}
+
+	resp, err := db.client.GetByNameSlug(ctx, &pb.GetByNameSlugRequest{Slug: slug})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObjects(db.registry, resp.Objects)
+}
+
+func (db *GRPCDB) ListObjects(ctx context.Context, kind string) ([]Object, error) {
+	if kind == "" {
+		return nil, errors.New("invalid request. empty kind")
+	}
+
+	resp, err := db.client.List(ctx, &pb.ListRequest{Kind: kind})
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObjects(db.registry, resp.Objects)
+}
+
+func (db *GRPCDB) DeleteObject(ctx context.Context, id string) error {
+	_, err := db.client.Delete(ctx, &pb.DeleteRequest{Id: id})
+	return err
+}
+
+func unmarshalObjects(registry *Registry, objects []*pb.GetResponse) ([]Object, error) {
+	var out []Object
+	for _, o := range objects {
+		object, err := registry.unmarshalObject(o.Kind, o.Payload)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, object)
+	}
+	return out, nil
+}
@@ -0,0 +1,128 @@
+package endordb
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/niks3089/endor-coding-test/endordb/proto"
+)
+
+// Server adapts an ObjectDB to the pb.ObjectDBServer gRPC service, so any
+// driver (redis, memory, ...) can be exposed to remote GRPCDB clients.
+type Server struct {
+	pb.UnimplementedObjectDBServer
+	db         ObjectDB
+	registry   *Registry
+	grpcServer *grpc.Server
+}
+
+// NewServer wraps db so it can be registered on a grpc.Server.
+func NewServer(db ObjectDB, opts ...Option) *Server {
+	return &Server{db: db, registry: newDriverOptions(opts).registry}
+}
+
+// Serve registers the server on a new grpc.Server and blocks, accepting
+// connections on lis. Call Stop to shut it down. Requests arrive encoded
+// with pb.CodecName (see GRPCDB); the server resolves that codec by name
+// from the registration in endordb/proto, so no server-side codec option is
+// needed here.
+func (s *Server) Serve(lis net.Listener) error {
+	s.grpcServer = grpc.NewServer()
+	pb.RegisterObjectDBServer(s.grpcServer, s)
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop gracefully shuts down the server started by Serve.
+func (s *Server) Stop() {
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
+}
+
+func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (*pb.PutResponse, error) {
+	object, err := s.registry.unmarshalObject(req.Kind, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := object.SetName(req.Name); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.Store(ctx, object); err != nil {
+		return nil, err
+	}
+	return &pb.PutResponse{Id: object.GetID()}, nil
+}
+
+func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+	object, err := s.db.GetObjectByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toGetResponse(object)
+}
+
+func (s *Server) GetByName(ctx context.Context, req *pb.GetByNameRequest) (*pb.GetByNameResponse, error) {
+	objects, err := s.db.GetObjectsByName(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := toGetResponses(objects)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetByNameResponse{Objects: resp}, nil
+}
+
+func (s *Server) GetByNameSlug(ctx context.Context, req *pb.GetByNameSlugRequest) (*pb.GetByNameResponse, error) {
+	objects, err := s.db.GetObjectsByNameSlug(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := toGetResponses(objects)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetByNameResponse{Objects: resp}, nil
+}
+
+func (s *Server) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	objects, err := s.db.ListObjects(ctx, req.Kind)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := toGetResponses(objects)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListResponse{Objects: resp}, nil
+}
+
+func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	if err := s.db.DeleteObject(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteResponse{}, nil
+}
+
+func toGetResponse(object Object) (*pb.GetResponse, error) {
+	payload, err := marshalObject(object)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetResponse{Kind: object.GetKind(), Name: object.GetName(), Payload: payload}, nil
+}
+
+func toGetResponses(objects []Object) ([]*pb.GetResponse, error) {
+	resp := make([]*pb.GetResponse, 0, len(objects))
+	for _, object := range objects {
+		r, err := toGetResponse(object)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
@@ -0,0 +1,140 @@
+package endordb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// LogOptions configures NewLoggingDB.
+type LogOptions struct {
+	// Format is an Apache-access-log-style template, modeled after
+	// go-json-rest's access-log-apache format. Supported verbs:
+	//
+	//   %m method name (Store, GetObjectByID, ...)
+	//   %k object kind
+	//   %i object id
+	//   %n object name
+	//   %T latency in seconds
+	//   %s "ok", or the error string
+	//
+	// When Format is empty (the default), each call is logged as a single
+	// JSON object instead.
+	Format string
+	// Logger receives one formatted line per call. Defaults to log.Default().
+	Logger *log.Logger
+}
+
+type loggingDB struct {
+	inner ObjectDB
+	opts  LogOptions
+}
+
+// NewLoggingDB wraps inner so every call emits one structured access-log
+// record: method, object id/kind/name where available, latency, and error.
+func NewLoggingDB(inner ObjectDB, opts LogOptions) ObjectDB {
+	if opts.Logger == nil {
+		opts.Logger = log.Default()
+	}
+	return &loggingDB{inner: inner, opts: opts}
+}
+
+type accessLogRecord struct {
+	Method   string  `json:"method"`
+	ID       string  `json:"id,omitempty"`
+	Kind     string  `json:"kind,omitempty"`
+	Name     string  `json:"name,omitempty"`
+	Duration float64 `json:"duration_seconds"`
+	Error    string  `json:"error,omitempty"`
+}
+
+func (d *loggingDB) emit(rec accessLogRecord) {
+	if d.opts.Format == "" {
+		line, err := json.Marshal(rec)
+		if err != nil {
+			d.opts.Logger.Printf("endordb: failed to marshal access log record: %v", err)
+			return
+		}
+		d.opts.Logger.Print(string(line))
+		return
+	}
+
+	status := "ok"
+	if rec.Error != "" {
+		status = rec.Error
+	}
+
+	replacer := strings.NewReplacer(
+		"%m", rec.Method,
+		"%k", rec.Kind,
+		"%i", rec.ID,
+		"%n", rec.Name,
+		"%T", fmt.Sprintf("%.6f", rec.Duration),
+		"%s", status,
+	)
+	d.opts.Logger.Print(replacer.Replace(d.opts.Format))
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func (d *loggingDB) Store(ctx context.Context, object Object) error {
+	start := time.Now()
+	err := d.inner.Store(ctx, object)
+	d.emit(accessLogRecord{
+		Method:   "Store",
+		ID:       object.GetID(),
+		Kind:     object.GetKind(),
+		Name:     object.GetName(),
+		Duration: time.Since(start).Seconds(),
+		Error:    errString(err),
+	})
+	return err
+}
+
+func (d *loggingDB) GetObjectByID(ctx context.Context, id string) (Object, error) {
+	start := time.Now()
+	object, err := d.inner.GetObjectByID(ctx, id)
+	rec := accessLogRecord{Method: "GetObjectByID", ID: id, Duration: time.Since(start).Seconds(), Error: errString(err)}
+	if object != nil {
+		rec.Kind = object.GetKind()
+		rec.Name = object.GetName()
+	}
+	d.emit(rec)
+	return object, err
+}
+
+func (d *loggingDB) GetObjectsByName(ctx context.Context, name string) ([]Object, error) {
+	start := time.Now()
+	objects, err := d.inner.GetObjectsByName(ctx, name)
+	d.emit(accessLogRecord{Method: "GetObjectsByName", Name: name, Duration: time.Since(start).Seconds(), Error: errString(err)})
+	return objects, err
+}
+
+func (d *loggingDB) GetObjectsByNameSlug(ctx context.Context, slug string) ([]Object, error) {
+	start := time.Now()
+	objects, err := d.inner.GetObjectsByNameSlug(ctx, slug)
+	d.emit(accessLogRecord{Method: "GetObjectsByNameSlug", Name: slug, Duration: time.Since(start).Seconds(), Error: errString(err)})
+	return objects, err
+}
+
+func (d *loggingDB) ListObjects(ctx context.Context, kind string) ([]Object, error) {
+	start := time.Now()
+	objects, err := d.inner.ListObjects(ctx, kind)
+	d.emit(accessLogRecord{Method: "ListObjects", Kind: kind, Duration: time.Since(start).Seconds(), Error: errString(err)})
+	return objects, err
+}
+
+func (d *loggingDB) DeleteObject(ctx context.Context, id string) error {
+	start := time.Now()
+	err := d.inner.DeleteObject(ctx, id)
+	d.emit(accessLogRecord{Method: "DeleteObject", ID: id, Duration: time.Since(start).Seconds(), Error: errString(err)})
+	return err
+}
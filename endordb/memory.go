@@ -0,0 +1,140 @@
+package endordb
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+func init() {
+	Register("memory", func(opts map[string]string) (ObjectDB, error) {
+		return NewMemoryDB(), nil
+	})
+}
+
+// MemoryDB is a map-based ObjectDB implementation. It keeps everything in
+// process memory, so it is mainly useful for tests and local development
+// where spinning up a real Redis instance is overkill.
+type MemoryDB struct {
+	mu       sync.RWMutex
+	objects  map[string]Object
+	registry *Registry
+}
+
+// NewMemoryDB returns an empty in-memory ObjectDB.
+func NewMemoryDB(opts ...Option) *MemoryDB {
+	return &MemoryDB{
+		objects:  make(map[string]Object),
+		registry: newDriverOptions(opts).registry,
+	}
+}
+
+func (db *MemoryDB) Store(ctx context.Context, object Object) error {
+	if object.GetName() == "" {
+		return errors.New("missing object name")
+	}
+	if object.GetKind() == "" {
+		return errors.New("missing object kind")
+	}
+	if _, err := db.registry.New(object.GetKind()); err != nil {
+		return err
+	}
+
+	id := uuid.New().String()
+	object.SetID(id)
+
+	stored, err := cloneObject(db.registry, object)
+	if err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.objects[id] = stored
+
+	return nil
+}
+
+func (db *MemoryDB) GetObjectByID(ctx context.Context, id string) (Object, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	object, ok := db.objects[id]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return cloneObject(db.registry, object)
+}
+
+func (db *MemoryDB) GetObjectsByName(ctx context.Context, name string) ([]Object, error) {
+	if name == "" {
+		return nil, errors.New("invalid request. empty name")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var objects []Object
+	for _, object := range db.objects {
+		if object.GetName() == name {
+			clone, err := cloneObject(db.registry, object)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, clone)
+		}
+	}
+	return objects, nil
+}
+
+func (db *MemoryDB) GetObjectsByNameSlug(ctx context.Context, slug string) ([]Object, error) {
+	if slug == "" {
+		return nil, errors.New("invalid request. empty slug")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var objects []Object
+	for _, object := range db.objects {
+		if slugify(object.GetName()) == slug {
+			clone, err := cloneObject(db.registry, object)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, clone)
+		}
+	}
+	return objects, nil
+}
+
+func (db *MemoryDB) ListObjects(ctx context.Context, kind string) ([]Object, error) {
+	if kind == "" {
+		return nil, errors.New("invalid request. empty kind")
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	var objects []Object
+	for _, object := range db.objects {
+		if object.GetKind() == kind {
+			clone, err := cloneObject(db.registry, object)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, clone)
+		}
+	}
+	return objects, nil
+}
+
+func (db *MemoryDB) DeleteObject(ctx context.Context, id string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	delete(db.objects, id)
+	return nil
+}
@@ -0,0 +1,95 @@
+package endordb
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metricsDB struct {
+	inner ObjectDB
+
+	opsTotal   *prometheus.CounterVec
+	opDuration *prometheus.HistogramVec
+}
+
+// NewMetricsDB wraps inner, exporting endordb_ops_total{method,kind,result}
+// counters and endordb_op_duration_seconds{method,kind} histograms to reg.
+// Like NewLoggingDB, this is a pure decorator over the ObjectDB interface
+// and needs no driver-specific knowledge.
+func NewMetricsDB(inner ObjectDB, reg prometheus.Registerer) ObjectDB {
+	d := &metricsDB{
+		inner: inner,
+		opsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "endordb_ops_total",
+			Help: "Total number of ObjectDB operations.",
+		}, []string{"method", "kind", "result"}),
+		opDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "endordb_op_duration_seconds",
+			Help: "ObjectDB operation latency in seconds.",
+		}, []string{"method", "kind"}),
+	}
+	reg.MustRegister(d.opsTotal, d.opDuration)
+	return d
+}
+
+func (d *metricsDB) observe(method, kind string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	d.opsTotal.WithLabelValues(method, kind, result).Inc()
+	d.opDuration.WithLabelValues(method, kind).Observe(time.Since(start).Seconds())
+}
+
+// kindOf returns object.GetKind(), or "" if object is nil (e.g. a failed
+// lookup), so metrics can still be recorded with a consistent label set.
+func kindOf(object Object) string {
+	if object == nil {
+		return ""
+	}
+	return object.GetKind()
+}
+
+func (d *metricsDB) Store(ctx context.Context, object Object) error {
+	start := time.Now()
+	err := d.inner.Store(ctx, object)
+	d.observe("Store", object.GetKind(), start, err)
+	return err
+}
+
+func (d *metricsDB) GetObjectByID(ctx context.Context, id string) (Object, error) {
+	start := time.Now()
+	object, err := d.inner.GetObjectByID(ctx, id)
+	d.observe("GetObjectByID", kindOf(object), start, err)
+	return object, err
+}
+
+func (d *metricsDB) GetObjectsByName(ctx context.Context, name string) ([]Object, error) {
+	start := time.Now()
+	objects, err := d.inner.GetObjectsByName(ctx, name)
+	d.observe("GetObjectsByName", "", start, err)
+	return objects, err
+}
+
+func (d *metricsDB) GetObjectsByNameSlug(ctx context.Context, slug string) ([]Object, error) {
+	start := time.Now()
+	objects, err := d.inner.GetObjectsByNameSlug(ctx, slug)
+	d.observe("GetObjectsByNameSlug", "", start, err)
+	return objects, err
+}
+
+func (d *metricsDB) ListObjects(ctx context.Context, kind string) ([]Object, error) {
+	start := time.Now()
+	objects, err := d.inner.ListObjects(ctx, kind)
+	d.observe("ListObjects", kind, start, err)
+	return objects, err
+}
+
+func (d *metricsDB) DeleteObject(ctx context.Context, id string) error {
+	start := time.Now()
+	err := d.inner.DeleteObject(ctx, id)
+	d.observe("DeleteObject", "", start, err)
+	return err
+}
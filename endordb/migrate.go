@@ -0,0 +1,83 @@
+package endordb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// LegacyKinds maps historical GetKind() values to the stable kind names they
+// should be migrated to. GetKind() used to return reflect.TypeOf(...).String(),
+// so the value on disk tracked wherever the Go types happened to live and
+// broke every time they moved.
+//
+// This only covers the "*endordb.Person"/"*endordb.Animal" tags written
+// between the move into the endordb package and the introduction of stable
+// kind constants: migrateKind looks up idx:kind:* sets against the record{}
+// wrapper, neither of which existed in the original package-main, KEYS-scan
+// layout, so a "*main.Person"/"*main.Animal" tag could never actually be
+// indexed this way. Migrating that older layout would need a separate,
+// KEYS-based pass over the pre-record format, not an entry here.
+var LegacyKinds = map[string]string{
+	"*endordb.Person": KindPerson,
+	"*endordb.Animal": KindAnimal,
+}
+
+// MigrateLegacyKinds rewrites every object stored under a legacy kind tag
+// (see LegacyKinds) to its stable replacement, fixing up both the canonical
+// record and the idx:kind:* secondary index. It returns the number of
+// objects migrated.
+func (db *RedisDB) MigrateLegacyKinds(ctx context.Context) (int, error) {
+	migrated := 0
+	for legacy, stable := range LegacyKinds {
+		n, err := db.migrateKind(ctx, legacy, stable)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += n
+	}
+	return migrated, nil
+}
+
+// migrateKind moves every id indexed under idx:kind:{from} to idx:kind:{to}
+// and rewrites the kind field of its canonical record.
+func (db *RedisDB) migrateKind(ctx context.Context, from, to string) (int, error) {
+	ids, err := db.client.SMembers(ctx, kindIndexKey(from)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, id := range ids {
+		rec, err := db.getRecord(ctx, id)
+		if err != nil {
+			// Record already gone; fall through and drop it from the stale
+			// index below.
+			continue
+		}
+		rec.Kind = to
+
+		value, err := json.Marshal(rec)
+		if err != nil {
+			return migrated, err
+		}
+
+		_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, objectKey(id), value, 0)
+			pipe.SRem(ctx, kindIndexKey(from), id)
+			pipe.SAdd(ctx, kindIndexKey(to), id)
+			return nil
+		})
+		if err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+
+	// Whatever is left over points at records that no longer exist.
+	if err := db.client.Del(ctx, kindIndexKey(from)).Err(); err != nil {
+		return migrated, err
+	}
+	return migrated, nil
+}
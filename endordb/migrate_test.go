@@ -0,0 +1,72 @@
+package endordb
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRedisDB(t *testing.T) *RedisDB {
+	t.Helper()
+
+	db, err := NewRedisDB(getEnv("REDIS_HOST", "127.0.0.1:6379"), getEnv("REDIS_PASSWORD", ""))
+	if err != nil {
+		t.Skipf("redis backend unavailable: %v", err)
+	}
+	if _, err := db.FlushAll(); err != nil {
+		t.Skipf("redis backend unavailable: %v", err)
+	}
+	return db
+}
+
+// retagAsLegacy rewrites id's stored record and kind index in place as if it
+// had been written by a pre-migration version that tagged kinds with a Go
+// type name instead of a stable constant, bypassing Store/RedisDB so the
+// legacy state can be reproduced directly.
+func retagAsLegacy(t *testing.T, db *RedisDB, id, stableKind, legacyKind string) {
+	t.Helper()
+
+	ctx := context.Background()
+	rec, err := db.getRecord(ctx, id)
+	assert.NoError(t, err)
+	rec.Kind = legacyKind
+
+	value, err := json.Marshal(rec)
+	assert.NoError(t, err)
+
+	_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, objectKey(id), value, 0)
+		pipe.SRem(ctx, kindIndexKey(stableKind), id)
+		pipe.SAdd(ctx, kindIndexKey(legacyKind), id)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestMigrateLegacyKinds(t *testing.T) {
+	db := newTestRedisDB(t)
+	ctx := context.Background()
+
+	person := getPerson("alice")
+	assert.NoError(t, db.Store(ctx, person))
+	retagAsLegacy(t, db, person.GetID(), KindPerson, "*endordb.Person")
+
+	migrated, err := db.MigrateLegacyKinds(ctx)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, migrated, 1)
+
+	obj, err := db.GetObjectByID(ctx, person.GetID())
+	assert.NoError(t, err)
+	assert.Equal(t, KindPerson, obj.GetKind())
+
+	legacyMember, err := db.client.SIsMember(ctx, kindIndexKey("*endordb.Person"), person.GetID()).Result()
+	assert.NoError(t, err)
+	assert.False(t, legacyMember)
+
+	stableMember, err := db.client.SIsMember(ctx, kindIndexKey(KindPerson), person.GetID()).Result()
+	assert.NoError(t, err)
+	assert.True(t, stableMember)
+}
@@ -0,0 +1,82 @@
+package endordb
+
+import (
+	"time"
+)
+
+// Stable kind names for the object types built into this package. Unlike
+// reflect.TypeOf(...).String(), these never change when the Go types backing
+// them are renamed or moved, so they are safe to persist.
+const (
+	KindPerson = "person"
+	KindAnimal = "animal"
+)
+
+// Object is anything that can be stored in an ObjectDB.
+type Object interface {
+	// GetKind returns the type of the object.
+	GetKind() string
+	// GetID returns a unique UUID for the object.
+	GetID() string
+	// GetName returns the name of the object. Names are not unique.
+	GetName() string
+	// SetID sets the ID of the object.
+	SetID(string)
+	// SetName sets the name of the object.
+	SetName(string) error
+}
+
+type Person struct {
+	Name      string    `json:"name"`
+	ID        string    `json:"id"`
+	LastName  string    `json:"last_name"`
+	Birthday  string    `json:"birthday"`
+	BirthDate time.Time `json:"birthdate"`
+}
+
+type Animal struct {
+	Name    string `json:"name"`
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	OwnerID string `json:"owner_id"`
+}
+
+func (p *Person) GetKind() string {
+	return KindPerson
+}
+
+func (p *Person) GetID() string {
+	return p.ID
+}
+
+func (p *Person) GetName() string {
+	return p.Name
+}
+func (p *Person) SetID(s string) {
+	p.ID = s
+}
+func (p *Person) SetName(s string) error {
+	p.Name = s
+	return nil
+}
+
+func (p *Animal) GetKind() string {
+	return KindAnimal
+}
+
+func (p *Animal) GetID() string {
+	return p.ID
+}
+
+func (p *Animal) GetName() string {
+	return p.Name
+}
+
+func (p *Animal) SetID(s string) {
+	p.ID = s
+}
+
+func (p *Animal) SetName(s string) error {
+	p.Name = s
+	return nil
+}
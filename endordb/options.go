@@ -0,0 +1,25 @@
+package endordb
+
+// Option configures a driver constructor (NewRedisDB, NewMemoryDB,
+// NewGRPCDB, ...).
+type Option func(*driverOptions)
+
+type driverOptions struct {
+	registry *Registry
+}
+
+func newDriverOptions(opts []Option) *driverOptions {
+	o := &driverOptions{registry: NewRegistry()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithRegistry overrides the Registry a driver uses to decode stored
+// payloads. Defaults to NewRegistry() (Person and Animal) when not given.
+func WithRegistry(registry *Registry) Option {
+	return func(o *driverOptions) {
+		o.registry = registry
+	}
+}
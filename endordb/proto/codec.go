@@ -0,0 +1,33 @@
+package proto
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// jsonCodec lets the message types in this package travel over gRPC as
+// plain JSON instead of wire-format protobuf. They are hand-maintained
+// structs, not generated by protoc, so they don't implement proto.Message
+// and can't use grpc's default "proto" codec.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+// CodecName is negotiated between client and server via
+// grpc.CallContentSubtype; see GRPCDB and Server.Serve.
+const CodecName = "json"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
@@ -0,0 +1,54 @@
+// Hand-maintained stand-ins for objectdb.proto's message types, kept in sync
+// by hand rather than by protoc-gen-go: they travel over the wire as JSON via
+// the codec in codec.go, not wire-format protobuf, so they deliberately don't
+// implement proto.Message. Do not regenerate this file with protoc/buf — the
+// output wouldn't satisfy the json codec GRPCDB and Server depend on.
+// source: objectdb.proto
+
+package proto
+
+type PutRequest struct {
+	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+type PutResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+type GetRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+type GetResponse struct {
+	Kind    string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+type GetByNameRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+type GetByNameResponse struct {
+	Objects []*GetResponse `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+type GetByNameSlugRequest struct {
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+type ListRequest struct {
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+type ListResponse struct {
+	Objects []*GetResponse `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+type DeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+type DeleteResponse struct{}
@@ -0,0 +1,222 @@
+// Hand-maintained stand-in for objectdb.proto's client/server API, kept in
+// sync by hand rather than by protoc-gen-go-grpc; see the note in
+// objectdb.pb.go. Do not regenerate this file with protoc/buf.
+// source: objectdb.proto
+
+package proto
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ObjectDBClient is the client API for the ObjectDB service.
+type ObjectDBClient interface {
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	GetByName(ctx context.Context, in *GetByNameRequest, opts ...grpc.CallOption) (*GetByNameResponse, error)
+	GetByNameSlug(ctx context.Context, in *GetByNameSlugRequest, opts ...grpc.CallOption) (*GetByNameResponse, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+}
+
+type objectDBClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewObjectDBClient(cc grpc.ClientConnInterface) ObjectDBClient {
+	return &objectDBClient{cc}
+}
+
+func (c *objectDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	if err := c.cc.Invoke(ctx, "/endordb.proto.ObjectDB/Put", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	if err := c.cc.Invoke(ctx, "/endordb.proto.ObjectDB/Get", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectDBClient) GetByName(ctx context.Context, in *GetByNameRequest, opts ...grpc.CallOption) (*GetByNameResponse, error) {
+	out := new(GetByNameResponse)
+	if err := c.cc.Invoke(ctx, "/endordb.proto.ObjectDB/GetByName", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectDBClient) GetByNameSlug(ctx context.Context, in *GetByNameSlugRequest, opts ...grpc.CallOption) (*GetByNameResponse, error) {
+	out := new(GetByNameResponse)
+	if err := c.cc.Invoke(ctx, "/endordb.proto.ObjectDB/GetByNameSlug", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	if err := c.cc.Invoke(ctx, "/endordb.proto.ObjectDB/List", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	if err := c.cc.Invoke(ctx, "/endordb.proto.ObjectDB/Delete", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ObjectDBServer is the server API for the ObjectDB service.
+type ObjectDBServer interface {
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	GetByName(context.Context, *GetByNameRequest) (*GetByNameResponse, error)
+	GetByNameSlug(context.Context, *GetByNameSlugRequest) (*GetByNameResponse, error)
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+}
+
+// UnimplementedObjectDBServer can be embedded in a server implementation for
+// forward compatibility with methods added to the service in the future.
+type UnimplementedObjectDBServer struct{}
+
+func (UnimplementedObjectDBServer) Put(context.Context, *PutRequest) (*PutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (UnimplementedObjectDBServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedObjectDBServer) GetByName(context.Context, *GetByNameRequest) (*GetByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByName not implemented")
+}
+func (UnimplementedObjectDBServer) GetByNameSlug(context.Context, *GetByNameSlugRequest) (*GetByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByNameSlug not implemented")
+}
+func (UnimplementedObjectDBServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedObjectDBServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterObjectDBServer(s grpc.ServiceRegistrar, srv ObjectDBServer) {
+	s.RegisterService(&ObjectDB_ServiceDesc, srv)
+}
+
+func _ObjectDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectDBServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/endordb.proto.ObjectDB/Put"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectDBServer).Put(ctx, req.(*PutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/endordb.proto.ObjectDB/Get"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectDBServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectDB_GetByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectDBServer).GetByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/endordb.proto.ObjectDB/GetByName"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectDBServer).GetByName(ctx, req.(*GetByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectDB_GetByNameSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByNameSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectDBServer).GetByNameSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/endordb.proto.ObjectDB/GetByNameSlug"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectDBServer).GetByNameSlug(ctx, req.(*GetByNameSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectDB_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectDBServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/endordb.proto.ObjectDB/List"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectDBServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/endordb.proto.ObjectDB/Delete"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectDBServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var ObjectDB_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "endordb.proto.ObjectDB",
+	HandlerType: (*ObjectDBServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Put", Handler: _ObjectDB_Put_Handler},
+		{MethodName: "Get", Handler: _ObjectDB_Get_Handler},
+		{MethodName: "GetByName", Handler: _ObjectDB_GetByName_Handler},
+		{MethodName: "GetByNameSlug", Handler: _ObjectDB_GetByNameSlug_Handler},
+		{MethodName: "List", Handler: _ObjectDB_List_Handler},
+		{MethodName: "Delete", Handler: _ObjectDB_Delete_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "objectdb.proto",
+}
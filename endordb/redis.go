@@ -0,0 +1,220 @@
+package endordb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func init() {
+	Register("redis", func(opts map[string]string) (ObjectDB, error) {
+		return NewRedisDB(opts["addr"], opts["password"])
+	})
+}
+
+// record is the canonical JSON payload stored at obj:{id}. Kind and Name are
+// kept alongside the object payload so GetObjectByID never needs a second
+// round trip, and so DeleteObject knows which secondary indexes to clean up.
+type record struct {
+	Kind    string          `json:"kind"`
+	Name    string          `json:"name"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func objectKey(id string) string {
+	return "obj:" + id
+}
+
+func kindIndexKey(kind string) string {
+	return "idx:kind:" + kind
+}
+
+// nameIndexKey is keyed by the slugified name, not the raw name, so unicode
+// and punctuation in object names never leaks into Redis key space. The
+// record itself still stores the original name, so callers can disambiguate
+// collisions (see GetObjectsByName vs GetObjectsByNameSlug).
+func nameIndexKey(slug string) string {
+	return "idx:name:" + slug
+}
+
+// RedisDB stores the canonical record for an object at obj:{id} and
+// maintains idx:kind:{kind} / idx:name:{slug(name)} sets of ids as secondary
+// indexes, avoiding full-keyspace KEYS scans.
+type RedisDB struct {
+	client   *redis.Client
+	registry *Registry
+}
+
+func NewRedisDB(addr, password string, opts ...Option) (*RedisDB, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: password,
+	})
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, err
+	}
+	return &RedisDB{client: client, registry: newDriverOptions(opts).registry}, nil
+}
+
+// FlushAll wipes every key in the connected Redis database. It is mainly
+// useful for tests and the demo in the root main package.
+func (db *RedisDB) FlushAll() (string, error) {
+	return db.client.FlushAll(context.Background()).Result()
+}
+
+func (db *RedisDB) Store(ctx context.Context, object Object) error {
+	if object.GetName() == "" {
+		return errors.New("missing object name")
+	}
+	if object.GetKind() == "" {
+		return errors.New("missing object kind")
+	}
+
+	id := uuid.New().String()
+	object.SetID(id)
+
+	payload, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(record{Kind: object.GetKind(), Name: object.GetName(), Payload: payload})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, objectKey(id), value, 0)
+		pipe.SAdd(ctx, kindIndexKey(object.GetKind()), id)
+		pipe.SAdd(ctx, nameIndexKey(slugify(object.GetName())), id)
+		return nil
+	})
+	return err
+}
+
+func (db *RedisDB) getRecord(ctx context.Context, id string) (*record, error) {
+	value, err := db.client.Get(ctx, objectKey(id)).Result()
+	if err == redis.Nil {
+		return nil, errors.New("object not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rec record
+	if err := json.Unmarshal([]byte(value), &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (db *RedisDB) GetObjectByID(ctx context.Context, id string) (Object, error) {
+	rec, err := db.getRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return db.registry.unmarshalObject(rec.Kind, rec.Payload)
+}
+
+// mgetByIndex resolves a secondary index (a set of ids) to objects via
+// SMEMBERS followed by a single pipelined MGET.
+func (db *RedisDB) mgetByIndex(ctx context.Context, indexKey string) ([]Object, error) {
+	ids, err := db.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = objectKey(id)
+	}
+
+	values, err := db.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]Object, 0, len(values))
+	for _, v := range values {
+		if v == nil {
+			// Index and record went out of sync, e.g. a concurrent delete.
+			continue
+		}
+
+		var rec record
+		if err := json.Unmarshal([]byte(v.(string)), &rec); err != nil {
+			return nil, err
+		}
+
+		object, err := db.registry.unmarshalObject(rec.Kind, rec.Payload)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+func (db *RedisDB) GetObjectsByName(ctx context.Context, name string) ([]Object, error) {
+	if name == "" {
+		return nil, errors.New("invalid request. empty name")
+	}
+
+	objects, err := db.mgetByIndex(ctx, nameIndexKey(slugify(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	// The index is keyed by slug, so it may hold objects whose name only
+	// collides with name's slug. Filter down to an exact match.
+	matches := make([]Object, 0, len(objects))
+	for _, object := range objects {
+		if object.GetName() == name {
+			matches = append(matches, object)
+		}
+	}
+	return matches, nil
+}
+
+// GetObjectsByNameSlug returns every object whose name slugifies to slug,
+// without filtering for an exact name match. Use this to resolve which
+// distinct names are colliding on a given slug.
+func (db *RedisDB) GetObjectsByNameSlug(ctx context.Context, slug string) ([]Object, error) {
+	if slug == "" {
+		return nil, errors.New("invalid request. empty slug")
+	}
+
+	return db.mgetByIndex(ctx, nameIndexKey(slug))
+}
+
+func (db *RedisDB) ListObjects(ctx context.Context, kind string) ([]Object, error) {
+	if kind == "" {
+		return nil, errors.New("invalid request. empty kind")
+	}
+
+	return db.mgetByIndex(ctx, kindIndexKey(kind))
+}
+
+func (db *RedisDB) DeleteObject(ctx context.Context, id string) error {
+	rec, err := db.getRecord(ctx, id)
+	if err != nil {
+		// Deleting an object that doesn't exist is a no-op, matching the
+		// previous KEYS-based behavior.
+		return nil
+	}
+
+	_, err = db.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SRem(ctx, kindIndexKey(rec.Kind), id)
+		pipe.SRem(ctx, nameIndexKey(slugify(rec.Name)), id)
+		pipe.Del(ctx, objectKey(id))
+		return nil
+	})
+	return err
+}
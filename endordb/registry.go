@@ -0,0 +1,74 @@
+package endordb
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Registry maps stable kind names to factories that construct a zero-value
+// Object of that kind. Drivers consult a Registry to decode stored payloads
+// instead of switching on Go's reflect.TypeOf(...).String(), so adding a new
+// Object implementation only requires a Register call, not an edit to every
+// driver.
+type Registry struct {
+	factories map[string]func() Object
+}
+
+// NewRegistry returns a Registry pre-populated with this package's built-in
+// Person and Animal kinds.
+func NewRegistry() *Registry {
+	r := &Registry{factories: make(map[string]func() Object)}
+	r.Register(KindPerson, func() Object { return &Person{} })
+	r.Register(KindAnimal, func() Object { return &Animal{} })
+	return r
+}
+
+// Register makes kind available for New to construct.
+func (r *Registry) Register(kind string, factory func() Object) {
+	if factory == nil {
+		panic("endordb: Registry.Register factory is nil")
+	}
+	if _, dup := r.factories[kind]; dup {
+		panic("endordb: Registry.Register called twice for kind " + kind)
+	}
+	r.factories[kind] = factory
+}
+
+// New constructs a zero-value Object for the given kind.
+func (r *Registry) New(kind string) (Object, error) {
+	factory, ok := r.factories[kind]
+	if !ok {
+		return nil, fmt.Errorf("endordb: unknown object kind %q", kind)
+	}
+	return factory(), nil
+}
+
+// unmarshalObject decodes the JSON payload of an object whose kind is
+// already known, e.g. from a secondary index or a GRPCDB response.
+func (r *Registry) unmarshalObject(kind string, payload []byte) (Object, error) {
+	object, err := r.New(kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// marshalObject encodes an object to the JSON payload stored by drivers.
+func marshalObject(object Object) ([]byte, error) {
+	return json.Marshal(object)
+}
+
+// cloneObject returns a deep copy of object by round-tripping it through
+// JSON, the same encoding RedisDB and GRPCDB already store/transmit objects
+// as. MemoryDB uses this on every Store and read so it shares their value
+// semantics instead of aliasing the caller's pointer.
+func cloneObject(registry *Registry, object Object) (Object, error) {
+	payload, err := marshalObject(object)
+	if err != nil {
+		return nil, err
+	}
+	return registry.unmarshalObject(object.GetKind(), payload)
+}
@@ -0,0 +1,21 @@
+package endordb
+
+import (
+	"regexp"
+	"strings"
+)
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify normalizes an object name for use inside the idx:name:* secondary
+// index key, following the extemporalgenome/slug approach: lowercase, collapse any run
+// of characters outside a-z0-9 into a single dash, and trim leading/trailing
+// dashes. This keeps arbitrary unicode and punctuation in object names out
+// of Redis key space, and means two names differing only in case or
+// punctuation (e.g. "Al Pacino" and "al-pacino") index together. Kinds are
+// not slugified: idx:kind:* keys use the kind string exactly as given.
+func slugify(s string) string {
+	s = strings.ToLower(s)
+	s = slugInvalidChars.ReplaceAllString(s, "-")
+	return strings.Trim(s, "-")
+}